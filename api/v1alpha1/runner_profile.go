@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RunnerProfile is an opinionated preset applied on top of RunnerSpec by
+// the mutating webhook, so users don't have to hand-tune every field for
+// common environments.
+//
+// NOTE: the mutating webhook that applies dev/preview/gitops defaults is
+// not implemented yet; today setting Profile only feeds validateProfile,
+// which enforces the gitops invariants at admission time but does not
+// default any fields.
+type RunnerProfile string
+
+const (
+	// RunnerProfileDev enables hostPath caches, disables ephemeral runners
+	// and mounts a debug sidecar with a permissive securityContext, for
+	// fast local iteration.
+	RunnerProfileDev RunnerProfile = "dev"
+	// RunnerProfilePreview uses conservative resource requests and short
+	// registration TTLs suitable for PR/preview environments.
+	RunnerProfilePreview RunnerProfile = "preview"
+	// RunnerProfileGitOps locks the pod down (readOnlyRootFilesystem, no
+	// privileged docker) and requires WorkVolumeClaimTemplate and
+	// ContainerMode=kubernetes. It does not yet enforce a seccomp profile:
+	// RunnerSpec.SeccompProfile can't be used until the controller
+	// translates it into the pod's securityContext (see its doc comment).
+	RunnerProfileGitOps RunnerProfile = "gitops"
+)
+
+// RunnerProfileAnnotationKey is the annotation used to select a
+// RunnerProfile when RunnerSpec.Profile is left unset.
+const RunnerProfileAnnotationKey = "runner.actions.summerwind.dev/profile"
+
+// GetProfileOrDefault returns the runner's effective profile, falling
+// back to the RunnerProfileAnnotationKey annotation on obj, and finally
+// to the empty string if neither is set.
+func (rs *RunnerSpec) GetProfileOrDefault(obj metav1.Object) RunnerProfile {
+	if rs.Profile != "" {
+		return rs.Profile
+	}
+
+	if obj != nil {
+		if v, ok := obj.GetAnnotations()[RunnerProfileAnnotationKey]; ok {
+			return RunnerProfile(v)
+		}
+	}
+
+	return ""
+}
+
+// IsDevProfile reports whether the runner's effective profile is dev.
+func (rs *RunnerSpec) IsDevProfile(obj metav1.Object) bool {
+	return rs.GetProfileOrDefault(obj) == RunnerProfileDev
+}
+
+// IsPreviewProfile reports whether the runner's effective profile is preview.
+func (rs *RunnerSpec) IsPreviewProfile(obj metav1.Object) bool {
+	return rs.GetProfileOrDefault(obj) == RunnerProfilePreview
+}
+
+// IsGitOpsProfile reports whether the runner's effective profile is gitops.
+func (rs *RunnerSpec) IsGitOpsProfile(obj metav1.Object) bool {
+	return rs.GetProfileOrDefault(obj) == RunnerProfileGitOps
+}
+
+// validateProfile enforces the invariants that the gitops profile locks
+// in place, so that a Runner can't loosen them. It only rejects specs; it
+// does not default any field.
+func (rs *RunnerSpec) validateProfile(obj metav1.Object) error {
+	switch rs.GetProfileOrDefault(obj) {
+	case "", RunnerProfileDev, RunnerProfilePreview:
+		return nil
+	case RunnerProfileGitOps:
+	default:
+		return errors.New("Spec.Profile must be one of dev, preview or gitops")
+	}
+
+	if rs.ContainerMode != "kubernetes" {
+		return errors.New("Profile gitops requires containerMode to be kubernetes")
+	}
+
+	if rs.WorkVolumeClaimTemplate == nil {
+		return errors.New("Profile gitops requires workVolumeClaimTemplate to be set")
+	}
+
+	// DockerEnabled defaults to true (a privileged docker sidecar) when
+	// left unset, so gitops must reject the nil case too, not just an
+	// explicit true.
+	if rs.DockerEnabled == nil || *rs.DockerEnabled {
+		return errors.New("Profile gitops requires dockerEnabled to be explicitly set to false")
+	}
+
+	if rs.SecurityContext != nil && rs.SecurityContext.RunAsNonRoot != nil && !*rs.SecurityContext.RunAsNonRoot {
+		return errors.New("Profile gitops does not allow disabling runAsNonRoot")
+	}
+
+	for _, c := range rs.Containers {
+		if c.SecurityContext != nil && c.SecurityContext.ReadOnlyRootFilesystem != nil && !*c.SecurityContext.ReadOnlyRootFilesystem {
+			return fmt.Errorf("Profile gitops does not allow disabling readOnlyRootFilesystem on container %q", c.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateProfile(t *testing.T) {
+	trueVal := true
+	falseVal := false
+
+	testCases := []struct {
+		name    string
+		rs      RunnerSpec
+		wantErr bool
+	}{
+		{
+			name:    "no profile",
+			rs:      RunnerSpec{},
+			wantErr: false,
+		},
+		{
+			name: "dev profile has no invariants",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{Profile: RunnerProfileDev},
+			},
+			wantErr: false,
+		},
+		{
+			name: "gitops profile requires containerMode kubernetes",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile requires workVolumeClaimTemplate",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:       RunnerProfileGitOps,
+					ContainerMode: "kubernetes",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile rejects dockerEnabled left unset (defaults to true)",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					ContainerMode:           "kubernetes",
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile rejects dockerEnabled=true",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					ContainerMode:           "kubernetes",
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+					DockerEnabled:           &trueVal,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile rejects runAsNonRoot=false",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					ContainerMode:           "kubernetes",
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+					DockerEnabled:           &falseVal,
+				},
+				RunnerPodSpec: RunnerPodSpec{
+					SecurityContext: &corev1.PodSecurityContext{RunAsNonRoot: &falseVal},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile rejects readOnlyRootFilesystem=false on a container",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					ContainerMode:           "kubernetes",
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+					DockerEnabled:           &falseVal,
+				},
+				RunnerPodSpec: RunnerPodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            "runner",
+							SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: &falseVal},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gitops profile satisfied",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{
+					Profile:                 RunnerProfileGitOps,
+					ContainerMode:           "kubernetes",
+					WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+					DockerEnabled:           &falseVal,
+				},
+				RunnerPodSpec: RunnerPodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            "runner",
+							SecurityContext: &corev1.SecurityContext{ReadOnlyRootFilesystem: &trueVal},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown profile",
+			rs: RunnerSpec{
+				RunnerConfig: RunnerConfig{Profile: "bogus"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rs.validateProfile(nil)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -127,13 +127,134 @@ type RunnerConfig struct {
 	// Allowed value is: kubernetes.
 	// Requires defining WorkVolumeClaimTemplate.
 	// More information: https://github.com/actions-runner-controller/actions-runner-controller/pull/1546
+	//
+	// podman is schema-valid but rejected by Validate: see PodmanConfig.
 	// +optional
-	// +kubebuilder:validation:Enum=kubernetes
+	// +kubebuilder:validation:Enum=kubernetes;podman
 	ContainerMode string `json:"containerMode,omitempty"`
 
+	// PodmanConfig is intended to customise the rootless Podman sidecar (or
+	// in-container Podman when DockerdWithinRunnerContainer is true)
+	// injected when ContainerMode is podman.
+	//
+	// NOTE: the controller doesn't inject the rootless Podman sidecar,
+	// /etc/subuid and /etc/subgid, or the fuse-overlayfs graph root yet, so
+	// Validate rejects ContainerMode: podman outright rather than silently
+	// producing the same pod as leaving ContainerMode unset.
+	// +optional
+	PodmanConfig *PodmanConfig `json:"podmanConfig,omitempty"`
+
+	// SeccompProfile is intended to be applied to the runner container's
+	// securityContext, and, unless overridden per-container by
+	// ContainerSeccompProfiles, to every other container in the pod
+	// (dockerd sidecar, user-supplied containers). On kubelets too old to
+	// support securityContext.seccompProfile the controller would fall
+	// back to the legacy seccomp pod annotations.
+	//
+	// NOTE: that translation into container securityContexts and legacy
+	// annotations isn't implemented in the controller yet, so Validate
+	// rejects this field outright rather than silently accepting a value
+	// that has no effect on the generated pod.
+	// +optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+
+	// AppArmorProfile is intended to be applied, via the legacy
+	// container.apparmor.security.beta.kubernetes.io/<container> pod
+	// annotation, to every container in the runner pod.
+	// NOTE: rejected by Validate until wired into the controller; see SeccompProfile.
+	// +optional
+	AppArmorProfile *string `json:"appArmorProfile,omitempty"`
+
+	// ContainerSeccompProfiles is intended to override SeccompProfile for
+	// specific containers in the runner pod, keyed by container name (e.g.
+	// "runner", "docker", or the name of a user-supplied container).
+	// NOTE: rejected by Validate until wired into the controller; see SeccompProfile.
+	// +optional
+	ContainerSeccompProfiles map[string]corev1.SeccompProfile `json:"containerSeccompProfiles,omitempty"`
+
+	// Profile selects an opinionated preset (dev, preview, gitops). Can also
+	// be set via the RunnerProfileAnnotationKey annotation instead. Validate
+	// enforces the gitops invariants (see validateProfile); no field of this
+	// spec is defaulted from the selected profile.
+	// +optional
+	// +kubebuilder:validation:Enum=dev;preview;gitops
+	Profile RunnerProfile `json:"profile,omitempty"`
+
+	// ScaleMode controls whether this runner's pod is always kept running
+	// (always) or is only materialized by the activator once a queued-job
+	// webhook arrives for it (onDemand). Defaults to always.
+	//
+	// NOTE: onDemand is rejected by Validate until the activator
+	// controller that gives it meaning is implemented, so that setting it
+	// today fails fast instead of silently behaving like always.
+	// +optional
+	// +kubebuilder:validation:Enum=always;onDemand
+	ScaleMode ScaleMode `json:"scaleMode,omitempty"`
+
+	// ActivationTimeout bounds how long the activator waits, after creating
+	// this runner in response to a RunnerActivation, for it to register
+	// with GitHub before the activation is marked expired. Only used when
+	// ScaleMode is onDemand, which is currently rejected; see ScaleMode.
+	// +optional
+	ActivationTimeout *metav1.Duration `json:"activationTimeout,omitempty"`
+
+	// ColdStartGracePeriod is added on top of ActivationTimeout to account
+	// for image pulls and node scale-up when the activator estimates
+	// whether an onDemand runner will be ready in time for its job. Only
+	// used when ScaleMode is onDemand, which is currently rejected; see
+	// ScaleMode.
+	// +optional
+	ColdStartGracePeriod *metav1.Duration `json:"coldStartGracePeriod,omitempty"`
+
+	// ExecutorRef references a cluster-scoped RunnerExecutor that is
+	// intended to determine the runtime used to execute jobs for this
+	// runner, once the controller derives sidecars/env/volumes/security
+	// context from it instead of the DockerdWithinRunnerContainer,
+	// DockerEnabled, DockerMTU and DockerRegistryMirror fields.
+	//
+	// NOTE: that controller wiring doesn't exist yet, so setting
+	// ExecutorRef currently has no effect and the Docker* fields above
+	// remain the only ones the controller honors; do not deprecate them
+	// until ExecutorRef is actually consumed.
+	// +optional
+	ExecutorRef *corev1.LocalObjectReference `json:"executorRef,omitempty"`
+
 	GitHubAPICredentialsFrom *GitHubAPICredentialsFrom `json:"githubAPICredentialsFrom,omitempty"`
 }
 
+// PodmanNetworkBackend selects the network backend used by the rootless
+// Podman sidecar injected when ContainerMode is podman.
+type PodmanNetworkBackend string
+
+const (
+	PodmanNetworkBackendNetavark PodmanNetworkBackend = "netavark"
+	PodmanNetworkBackendCNI      PodmanNetworkBackend = "cni"
+)
+
+// PodmanConfig configures the rootless Podman runtime used when
+// RunnerConfig.ContainerMode is podman.
+type PodmanConfig struct {
+	// StorageDriver is passed to Podman's storage.conf. Defaults to
+	// fuse-overlayfs, which doesn't require privileged mode.
+	// +optional
+	StorageDriver string `json:"storageDriver,omitempty"`
+
+	// NetworkBackend selects Podman's network stack. Defaults to netavark.
+	// +optional
+	// +kubebuilder:validation:Enum=netavark;cni
+	NetworkBackend PodmanNetworkBackend `json:"networkBackend,omitempty"`
+
+	// RegistryMirror is the URL of a registry mirror written into Podman's
+	// registries.conf, analogous to RunnerConfig.DockerRegistryMirror.
+	// +optional
+	RegistryMirror *string `json:"registryMirror,omitempty"`
+
+	// GraphRootSizeLimit bounds the emptyDir used for Podman's graph root
+	// when WorkVolumeClaimTemplate is not set.
+	// +optional
+	GraphRootSizeLimit *resource.Quantity `json:"graphRootSizeLimit,omitempty"`
+}
+
 type GitHubAPICredentialsFrom struct {
 	SecretRef SecretReference `json:"secretRef,omitempty"`
 }
@@ -262,7 +383,17 @@ type RunnerPodSpec struct {
 	WorkVolumeClaimTemplate *WorkVolumeClaimTemplate `json:"workVolumeClaimTemplate,omitempty"`
 }
 
-func (rs *RunnerSpec) Validate(rootPath *field.Path) field.ErrorList {
+// Validate validates rs. obj is the Runner (or the RunnerDeployment/RunnerSet
+// templating it) that rs belongs to, and is consulted for the
+// RunnerProfileAnnotationKey annotation when rs.Profile is unset; it's
+// variadic, and may be omitted entirely, so existing callers that only
+// pass rootPath keep compiling. Only the first obj argument is used.
+func (rs *RunnerSpec) Validate(rootPath *field.Path, obj ...metav1.Object) field.ErrorList {
+	var profileObj metav1.Object
+	if len(obj) > 0 {
+		profileObj = obj[0]
+	}
+
 	var (
 		errList field.ErrorList
 		err     error
@@ -278,9 +409,67 @@ func (rs *RunnerSpec) Validate(rootPath *field.Path) field.ErrorList {
 		errList = append(errList, field.Invalid(rootPath.Child("workVolumeClaimTemplate"), rs.WorkVolumeClaimTemplate, err.Error()))
 	}
 
+	err = rs.validateContainerMode()
+	if err != nil {
+		errList = append(errList, field.Invalid(rootPath.Child("containerMode"), rs.ContainerMode, err.Error()))
+	}
+
+	err = rs.validateProfile(profileObj)
+	if err != nil {
+		errList = append(errList, field.Invalid(rootPath.Child("profile"), rs.Profile, err.Error()))
+	}
+
+	err = rs.validateScaleMode()
+	if err != nil {
+		errList = append(errList, field.Invalid(rootPath.Child("scaleMode"), rs.ScaleMode, err.Error()))
+	}
+
+	// SeccompProfile, AppArmorProfile and ContainerSeccompProfiles aren't
+	// translated into the pod's securityContext or legacy annotations by
+	// any controller yet, so setting them today would silently produce an
+	// unhardened pod. Reject them outright until that wiring lands, rather
+	// than accepting a field that quietly does nothing.
+	if rs.SeccompProfile != nil {
+		errList = append(errList, field.Invalid(rootPath.Child("seccompProfile"), rs.SeccompProfile, "not supported yet: the controller does not translate SeccompProfile into the pod's securityContext"))
+	}
+
+	if rs.AppArmorProfile != nil {
+		errList = append(errList, field.Invalid(rootPath.Child("appArmorProfile"), *rs.AppArmorProfile, "not supported yet: the controller does not translate AppArmorProfile into a pod annotation"))
+	}
+
+	if len(rs.ContainerSeccompProfiles) > 0 {
+		errList = append(errList, field.Invalid(rootPath.Child("containerSeccompProfiles"), rs.ContainerSeccompProfiles, "not supported yet: the controller does not translate ContainerSeccompProfiles into container securityContexts"))
+	}
+
 	return errList
 }
 
+// validateScaleMode rejects onDemand until the activator controller that
+// gives it meaning exists; today it would otherwise be a silent no-op,
+// leaving the runner running in always mode regardless of what's set.
+func (rs *RunnerSpec) validateScaleMode() error {
+	if rs.ScaleMode == ScaleModeOnDemand {
+		return errors.New("Spec.ScaleMode: onDemand is not supported yet, the activator controller has not been implemented")
+	}
+
+	return nil
+}
+
+// validateSeccompProfile rejects a Localhost type SeccompProfile that
+// doesn't name the profile file to load, mirroring the validation the
+// Kubernetes API server itself applies to pod securityContexts.
+func validateSeccompProfile(p *corev1.SeccompProfile) error {
+	if p == nil {
+		return nil
+	}
+
+	if p.Type == corev1.SeccompProfileTypeLocalhost && (p.LocalhostProfile == nil || *p.LocalhostProfile == "") {
+		return errors.New("SeccompProfile of type Localhost must have LocalhostProfile set")
+	}
+
+	return nil
+}
+
 // ValidateRepository validates repository field.
 func (rs *RunnerSpec) validateRepository() error {
 	// Enterprise, Organization and repository are both exclusive.
@@ -304,13 +493,30 @@ func (rs *RunnerSpec) validateRepository() error {
 	return nil
 }
 
-func (rs *RunnerSpec) validateWorkVolumeClaimTemplate() error {
-	if rs.ContainerMode != "kubernetes" {
-		return nil
+// validateContainerMode rejects podman until the controller actually
+// injects the rootless Podman sidecar, /etc/subuid and /etc/subgid, and
+// fuse-overlayfs graph root it implies; today it would otherwise be
+// accepted and silently produce the same pod as leaving ContainerMode unset.
+func (rs *RunnerSpec) validateContainerMode() error {
+	if rs.ContainerMode == "podman" {
+		return errors.New("Spec.ContainerMode: podman is not supported yet, the controller does not inject the rootless Podman sidecar")
 	}
 
-	if rs.WorkVolumeClaimTemplate == nil {
-		return errors.New("Spec.ContainerMode: kubernetes must have workVolumeClaimTemplate field specified")
+	return nil
+}
+
+func (rs *RunnerSpec) validateWorkVolumeClaimTemplate() error {
+	switch rs.ContainerMode {
+	case "kubernetes":
+		if rs.WorkVolumeClaimTemplate == nil {
+			return errors.New("Spec.ContainerMode: kubernetes must have workVolumeClaimTemplate field specified")
+		}
+	case "podman":
+		if rs.WorkVolumeClaimTemplate == nil {
+			return errors.New("Spec.ContainerMode: podman must have workVolumeClaimTemplate field specified")
+		}
+	default:
+		return nil
 	}
 
 	return rs.WorkVolumeClaimTemplate.validate()
@@ -357,7 +563,7 @@ func (w *WorkVolumeClaimTemplate) validate() error {
 
 	for _, accessMode := range w.AccessModes {
 		switch accessMode {
-		case corev1.ReadWriteOnce, corev1.ReadWriteMany:
+		case corev1.ReadWriteOnce, corev1.ReadWriteMany, corev1.ReadWriteOncePod:
 		default:
 			return fmt.Errorf("Access mode %v is not supported", accessMode)
 		}
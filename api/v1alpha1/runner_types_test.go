@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateScaleMode(t *testing.T) {
+	testCases := []struct {
+		name      string
+		scaleMode ScaleMode
+		wantErr   bool
+	}{
+		{name: "unset", scaleMode: "", wantErr: false},
+		{name: "always", scaleMode: ScaleModeAlways, wantErr: false},
+		{name: "onDemand is rejected until the activator exists", scaleMode: ScaleModeOnDemand, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := RunnerSpec{RunnerConfig: RunnerConfig{ScaleMode: tc.scaleMode}}
+			err := rs.validateScaleMode()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateSeccompProfile(t *testing.T) {
+	localhostProfile := "profiles/audit.json"
+
+	testCases := []struct {
+		name    string
+		profile *corev1.SeccompProfile
+		wantErr bool
+	}{
+		{
+			name:    "nil profile",
+			profile: nil,
+			wantErr: false,
+		},
+		{
+			name:    "RuntimeDefault",
+			profile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			wantErr: false,
+		},
+		{
+			name:    "Unconfined",
+			profile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+			wantErr: false,
+		},
+		{
+			name:    "Localhost without LocalhostProfile",
+			profile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost},
+			wantErr: true,
+		},
+		{
+			name:    "Localhost with empty LocalhostProfile",
+			profile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: new(string)},
+			wantErr: true,
+		},
+		{
+			name:    "Localhost with LocalhostProfile",
+			profile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeLocalhost, LocalhostProfile: &localhostProfile},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSeccompProfile(tc.profile)
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateWorkVolumeClaimTemplate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rs      RunnerSpec
+		wantErr bool
+	}{
+		{
+			name:    "no containerMode",
+			rs:      RunnerSpec{},
+			wantErr: false,
+		},
+		{
+			name:    "kubernetes without workVolumeClaimTemplate",
+			rs:      RunnerSpec{RunnerConfig: RunnerConfig{ContainerMode: "kubernetes"}},
+			wantErr: true,
+		},
+		{
+			name:    "podman without workVolumeClaimTemplate",
+			rs:      RunnerSpec{RunnerConfig: RunnerConfig{ContainerMode: "podman"}},
+			wantErr: true,
+		},
+		{
+			name: "podman with workVolumeClaimTemplate",
+			rs: RunnerSpec{RunnerConfig: RunnerConfig{
+				ContainerMode: "podman",
+				WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOncePod},
+				},
+			}},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rs.validateWorkVolumeClaimTemplate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRejectsUnimplementedSecurityHardening(t *testing.T) {
+	appArmor := "runtime/default"
+
+	testCases := []struct {
+		name string
+		rs   RunnerSpec
+	}{
+		{
+			name: "seccompProfile",
+			rs: RunnerSpec{RunnerConfig: RunnerConfig{
+				Enterprise:     "some-enterprise",
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			}},
+		},
+		{
+			name: "appArmorProfile",
+			rs: RunnerSpec{RunnerConfig: RunnerConfig{
+				Enterprise:      "some-enterprise",
+				AppArmorProfile: &appArmor,
+			}},
+		},
+		{
+			name: "containerSeccompProfiles",
+			rs: RunnerSpec{RunnerConfig: RunnerConfig{
+				Enterprise: "some-enterprise",
+				ContainerSeccompProfiles: map[string]corev1.SeccompProfile{
+					"runner": {Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errList := tc.rs.Validate(field.NewPath("spec"))
+			if len(errList) == 0 {
+				t.Errorf("expected Validate to reject an unimplemented security hardening field, got no errors")
+			}
+		})
+	}
+}
+
+func TestValidateContainerMode(t *testing.T) {
+	testCases := []struct {
+		name          string
+		containerMode string
+		wantErr       bool
+	}{
+		{name: "unset", containerMode: "", wantErr: false},
+		{name: "kubernetes", containerMode: "kubernetes", wantErr: false},
+		{name: "podman is rejected until the sidecar injection exists", containerMode: "podman", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := RunnerSpec{RunnerConfig: RunnerConfig{ContainerMode: tc.containerMode}}
+			err := rs.validateContainerMode()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaleMode controls whether a Runner (or the RunnerDeployment/RunnerSet
+// that templates it) keeps a pod running at all times, or is materialized
+// on demand by the activator when a job is queued.
+type ScaleMode string
+
+const (
+	// ScaleModeAlways keeps the runner pod running, matching today's behavior.
+	ScaleModeAlways ScaleMode = "always"
+	// ScaleModeOnDemand defers creating the runner pod until a queued-job
+	// webhook arrives for it, and tears it down after the job completes.
+	ScaleModeOnDemand ScaleMode = "onDemand"
+)
+
+// RunnerActivationSpec records a single queued-job webhook that is
+// awaiting an ephemeral Runner to service it. The activator controller
+// watches RunnerActivation objects, materializes the Runner, and deletes
+// the RunnerActivation once the runner has registered.
+type RunnerActivationSpec struct {
+	// RunnerDeploymentRef is the name of the RunnerDeployment (or RunnerSet)
+	// that should scale up to service this activation.
+	RunnerDeploymentRef string `json:"runnerDeploymentRef"`
+
+	// WorkflowJobID is the GitHub workflow job ID from the queued-job
+	// webhook that triggered this activation.
+	WorkflowJobID int64 `json:"workflowJobID"`
+
+	// Labels are the runner labels requested by the queued job, used to
+	// match this activation against the correct RunnerDeployment.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+}
+
+// RunnerActivationPhase is the set of phases a RunnerActivation moves
+// through as the activator services it.
+type RunnerActivationPhase string
+
+const (
+	RunnerActivationPhasePending    RunnerActivationPhase = "Pending"
+	RunnerActivationPhaseRegistered RunnerActivationPhase = "Registered"
+	RunnerActivationPhaseCompleted  RunnerActivationPhase = "Completed"
+	RunnerActivationPhaseExpired    RunnerActivationPhase = "Expired"
+)
+
+// RunnerActivationStatus reflects progress towards servicing a
+// RunnerActivation.
+type RunnerActivationStatus struct {
+	// RunnerName is set once the activator has created the ephemeral Runner
+	// for this activation.
+	// +optional
+	RunnerName string `json:"runnerName,omitempty"`
+
+	// Phase is one of Pending, Registered, Completed or Expired.
+	// +optional
+	// +kubebuilder:validation:Enum=Pending;Registered;Completed;Expired
+	Phase RunnerActivationPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +nullable
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.runnerDeploymentRef",name=RunnerDeployment,type=string
+// +kubebuilder:printcolumn:JSONPath=".status.phase",name=Status,type=string
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RunnerActivation is the Schema for the runneractivations API. It is the
+// internal, webhook-populated record that the activator controller
+// consumes to spin up exactly one ephemeral Runner per queued job when
+// ScaleMode is onDemand.
+//
+// NOTE: neither the activator controller that watches this type, the
+// webhook-server wiring that populates it from queued-job events, nor the
+// pending-activations metric described in the request exist yet.
+// RunnerSpec.Validate rejects ScaleMode: onDemand until they do, rather
+// than silently behaving like always.
+type RunnerActivation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RunnerActivationSpec   `json:"spec,omitempty"`
+	Status RunnerActivationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RunnerActivationList contains a list of RunnerActivation
+type RunnerActivationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RunnerActivation `json:"items"`
+}
+
+// RunnerRegistrationSpec is a lightweight, persisted record of a queued
+// job's activation so the activator can survive restarts without
+// re-consuming the originating webhook delivery.
+type RunnerRegistrationSpec struct {
+	// WorkflowJobID is the GitHub workflow job ID this registration was
+	// created for.
+	WorkflowJobID int64 `json:"workflowJobID"`
+
+	// RunnerActivationRef is the name of the RunnerActivation that owns
+	// this registration.
+	RunnerActivationRef string `json:"runnerActivationRef"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:JSONPath=".spec.runnerActivationRef",name=RunnerActivation,type=string
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RunnerRegistration is the Schema for the runnerregistrations API.
+type RunnerRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RunnerRegistrationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RunnerRegistrationList contains a list of RunnerRegistration
+type RunnerRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RunnerRegistration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RunnerActivation{}, &RunnerActivationList{})
+	SchemeBuilder.Register(&RunnerRegistration{}, &RunnerRegistrationList{})
+}
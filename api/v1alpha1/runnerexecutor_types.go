@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RunnerExecutorRuntime is the set of runtimes a RunnerExecutor can
+// configure the runner controller to use.
+type RunnerExecutorRuntime string
+
+const (
+	RunnerExecutorRuntimeDindSidecar             RunnerExecutorRuntime = "dind-sidecar"
+	RunnerExecutorRuntimeDindInRunner            RunnerExecutorRuntime = "dind-in-runner"
+	RunnerExecutorRuntimeKubernetesContainerJobs RunnerExecutorRuntime = "kubernetes-container-jobs"
+	RunnerExecutorRuntimeRootlessPodman          RunnerExecutorRuntime = "rootless-podman"
+	RunnerExecutorRuntimeKata                    RunnerExecutorRuntime = "kata"
+	RunnerExecutorRuntimeSysbox                  RunnerExecutorRuntime = "sysbox"
+)
+
+// RunnerExecutorSpec defines the runtime policy that a RunnerExecutor
+// applies to any Runner referencing it via RunnerSpec.ExecutorRef.
+type RunnerExecutorSpec struct {
+	// Runtime selects how job containers are executed for runners that
+	// reference this executor.
+	// +kubebuilder:validation:Enum=dind-sidecar;dind-in-runner;kubernetes-container-jobs;rootless-podman;kata;sysbox
+	Runtime RunnerExecutorRuntime `json:"runtime"`
+
+	// DockerMTU is used when Runtime is dind-sidecar or dind-in-runner.
+	// +optional
+	DockerMTU *int64 `json:"dockerMTU,omitempty"`
+
+	// DockerRegistryMirror is used when Runtime is dind-sidecar or dind-in-runner.
+	// +optional
+	DockerRegistryMirror *string `json:"dockerRegistryMirror,omitempty"`
+
+	// Privileged controls whether the runtime's sidecar or in-runner daemon
+	// runs in a privileged security context. Defaults to true for the
+	// dind-sidecar and dind-in-runner runtimes, and is ignored otherwise.
+	// +optional
+	Privileged *bool `json:"privileged,omitempty"`
+
+	// WorkVolumeClaimTemplate is used as the default work volume for any
+	// Runner that references this executor and doesn't set its own.
+	// +optional
+	WorkVolumeClaimTemplate *WorkVolumeClaimTemplate `json:"workVolumeClaimTemplate,omitempty"`
+
+	// Image overrides the default sidecar or in-runner image used to run
+	// this executor's runtime, e.g. a pinned dind or podman image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources overrides the default resource requirements applied to the
+	// runtime's sidecar container. Ignored for in-runner runtimes.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+func (s *RunnerExecutorSpec) validate() error {
+	switch s.Runtime {
+	case RunnerExecutorRuntimeDindSidecar,
+		RunnerExecutorRuntimeDindInRunner,
+		RunnerExecutorRuntimeKubernetesContainerJobs,
+		RunnerExecutorRuntimeRootlessPodman,
+		RunnerExecutorRuntimeKata,
+		RunnerExecutorRuntimeSysbox:
+	case "":
+		return errors.New("Spec.Runtime is required")
+	default:
+		return fmt.Errorf("Runtime %q is not supported", s.Runtime)
+	}
+
+	if s.Runtime == RunnerExecutorRuntimeKubernetesContainerJobs && s.WorkVolumeClaimTemplate == nil {
+		return errors.New("Runtime kubernetes-container-jobs must have workVolumeClaimTemplate field specified")
+	}
+
+	if s.WorkVolumeClaimTemplate != nil {
+		if err := s.WorkVolumeClaimTemplate.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:JSONPath=".spec.runtime",name=Runtime,type=string
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RunnerExecutor is the Schema for the runnerexecutors API. It lets
+// cluster admins standardize how job containers are executed across many
+// Runners and RunnerDeployments without repeating the same Docker*
+// fields on every RunnerSpec.
+//
+// NOTE: the runner controller doesn't derive sidecars/env/volumes/security
+// context from a referenced RunnerExecutor yet, so setting
+// RunnerSpec.ExecutorRef today has no effect on the generated pod. Because
+// the reference is resolved by name against the API server rather than
+// inlined, RunnerSpec.Validate also can't check that ContainerMode is
+// consistent with the referenced executor's Runtime; that cross-object
+// check belongs in the controller/webhook once they exist, where a client
+// is available to fetch the RunnerExecutor.
+type RunnerExecutor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RunnerExecutorSpec `json:"spec,omitempty"`
+}
+
+// Validate validates the RunnerExecutor's spec.
+func (e *RunnerExecutor) Validate() error {
+	return e.Spec.validate()
+}
+
+// +kubebuilder:object:root=true
+
+// RunnerExecutorList contains a list of RunnerExecutor
+type RunnerExecutorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RunnerExecutor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RunnerExecutor{}, &RunnerExecutorList{})
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The actions-runner-controller authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRunnerExecutorSpecValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		spec    RunnerExecutorSpec
+		wantErr bool
+	}{
+		{
+			name:    "empty runtime",
+			spec:    RunnerExecutorSpec{},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported runtime",
+			spec:    RunnerExecutorSpec{Runtime: "bogus"},
+			wantErr: true,
+		},
+		{
+			name:    "dind-sidecar without workVolumeClaimTemplate is fine",
+			spec:    RunnerExecutorSpec{Runtime: RunnerExecutorRuntimeDindSidecar},
+			wantErr: false,
+		},
+		{
+			name:    "kubernetes-container-jobs requires workVolumeClaimTemplate",
+			spec:    RunnerExecutorSpec{Runtime: RunnerExecutorRuntimeKubernetesContainerJobs},
+			wantErr: true,
+		},
+		{
+			name: "kubernetes-container-jobs with workVolumeClaimTemplate",
+			spec: RunnerExecutorSpec{
+				Runtime: RunnerExecutorRuntimeKubernetesContainerJobs,
+				WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid workVolumeClaimTemplate",
+			spec: RunnerExecutorSpec{
+				Runtime:                 RunnerExecutorRuntimeRootlessPodman,
+				WorkVolumeClaimTemplate: &WorkVolumeClaimTemplate{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.validate()
+			if tc.wantErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}